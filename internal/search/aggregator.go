@@ -0,0 +1,83 @@
+package search
+
+import (
+	"context"
+	"sync"
+)
+
+// Aggregator fans a query out to multiple Providers concurrently, merges
+// their results, and deduplicates by URL. It lets a caller degrade
+// gracefully when one provider is down, or enrich results by combining
+// several.
+type Aggregator struct {
+	providers []Provider
+}
+
+// NewAggregator creates an Aggregator over the given providers, queried in
+// the order given for the purposes of duplicate resolution (the first
+// provider to return a given URL wins).
+func NewAggregator(providers ...Provider) *Aggregator {
+	return &Aggregator{providers: providers}
+}
+
+type providerOutcome struct {
+	index int
+	resp  *Response
+	err   error
+}
+
+// Search queries every provider concurrently and merges the results. A
+// provider error does not fail the whole call; results are returned from
+// whichever providers succeeded. If every provider fails, the first error
+// encountered is returned.
+func (a *Aggregator) Search(ctx context.Context, query string, opts *Options) (*Response, error) {
+	outcomes := make([]providerOutcome, len(a.providers))
+
+	var wg sync.WaitGroup
+	for i, p := range a.providers {
+		wg.Add(1)
+		go func(i int, p Provider) {
+			defer wg.Done()
+			resp, err := p.Search(ctx, query, opts)
+			outcomes[i] = providerOutcome{index: i, resp: resp, err: err}
+		}(i, p)
+	}
+	wg.Wait()
+
+	merged := &Response{}
+	seen := make(map[string]bool)
+
+	var firstErr error
+	var anySucceeded bool
+
+	for _, o := range outcomes {
+		if o.err != nil {
+			if firstErr == nil {
+				firstErr = o.err
+			}
+			continue
+		}
+		anySucceeded = true
+
+		if o.resp == nil {
+			continue
+		}
+
+		for _, r := range o.resp.Results {
+			if r.URL != "" && seen[r.URL] {
+				continue
+			}
+			if r.URL != "" {
+				seen[r.URL] = true
+			}
+			merged.Results = append(merged.Results, r)
+		}
+		merged.Suggestions = append(merged.Suggestions, o.resp.Suggestions...)
+	}
+
+	if !anySucceeded {
+		return nil, firstErr
+	}
+
+	return merged, nil
+}
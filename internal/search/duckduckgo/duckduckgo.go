@@ -0,0 +1,113 @@
+// Package duckduckgo implements search.Provider by scraping DuckDuckGo's
+// JS-free HTML endpoint (html.duckduckgo.com), which is intended for
+// lightweight/no-JS clients and is considerably more stable to parse than
+// the main site.
+package duckduckgo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/PanacheTechnologies/Champollion/internal/search"
+)
+
+const baseURL = "https://html.duckduckgo.com/html/"
+
+// Provider scrapes DuckDuckGo's HTML search results.
+type Provider struct {
+	HTTPClient *http.Client
+	UserAgent  string
+}
+
+// NewProvider creates a DuckDuckGo search provider using sensible defaults.
+func NewProvider() *Provider {
+	return &Provider{
+		HTTPClient: http.DefaultClient,
+		UserAgent:  "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+	}
+}
+
+// Name identifies this provider as "duckduckgo".
+func (p *Provider) Name() string {
+	return "duckduckgo"
+}
+
+// resultPattern matches each result anchor in the HTML endpoint's markup:
+// `<a rel="nofollow" class="result__a" href="...">Title</a>`.
+var resultPattern = regexp.MustCompile(`(?s)<a rel="nofollow" class="result__a" href="([^"]+)">(.*?)</a>`)
+
+var tagStripper = regexp.MustCompile(`<[^>]*>`)
+
+// Search scrapes the DuckDuckGo HTML endpoint for the given query.
+func (p *Provider) Search(ctx context.Context, query string, opts *search.Options) (*search.Response, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	if opts != nil && opts.Language != "" {
+		params.Set("kl", opts.Language)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", p.UserAgent)
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("duckduckgo: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("duckduckgo: unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("duckduckgo: reading response: %w", err)
+	}
+
+	out := &search.Response{}
+	for _, m := range resultPattern.FindAllStringSubmatch(string(body), -1) {
+		resultURL := unwrapRedirect(m[1])
+		title := strings.TrimSpace(tagStripper.ReplaceAllString(m[2], ""))
+		if resultURL == "" || title == "" {
+			continue
+		}
+
+		out.Results = append(out.Results, &search.Result{
+			Title:    title,
+			URL:      resultURL,
+			Provider: p.Name(),
+			Kind:     search.KindText,
+		})
+	}
+
+	return out, nil
+}
+
+// unwrapRedirect extracts the real destination from DuckDuckGo's
+// "//duckduckgo.com/l/?uddg=<encoded>&..." redirect links.
+func unwrapRedirect(href string) string {
+	if strings.HasPrefix(href, "http") {
+		return href
+	}
+
+	u, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+
+	if target := u.Query().Get("uddg"); target != "" {
+		if decoded, err := url.QueryUnescape(target); err == nil {
+			return decoded
+		}
+	}
+
+	return ""
+}
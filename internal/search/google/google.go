@@ -0,0 +1,113 @@
+// Package google implements search.Provider by scraping Google's public web
+// search results page. There is no official free API for general web
+// search, so this parses the rendered HTML, which is inherently brittle —
+// Google is free to change its markup at any time.
+package google
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/PanacheTechnologies/Champollion/internal/search"
+)
+
+const baseURL = "https://www.google.com/search"
+
+// Provider scrapes Google's web search results.
+type Provider struct {
+	HTTPClient *http.Client
+	UserAgent  string
+}
+
+// NewProvider creates a Google search provider using sensible defaults.
+func NewProvider() *Provider {
+	return &Provider{
+		HTTPClient: http.DefaultClient,
+		UserAgent:  "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+	}
+}
+
+// Name identifies this provider as "google".
+func (p *Provider) Name() string {
+	return "google"
+}
+
+// resultPattern extracts the href and anchor text of each organic result
+// link from a Google SERP. Google wraps result links in
+// `<a href="...">...<h3>Title</h3>`.
+var resultPattern = regexp.MustCompile(`(?s)<a href="(/url\?q=|https?://)([^"&]+)[^"]*"[^>]*>.*?<h3[^>]*>(.*?)</h3>`)
+
+var tagStripper = regexp.MustCompile(`<[^>]*>`)
+
+// Search scrapes the Google SERP for the given query.
+func (p *Provider) Search(ctx context.Context, query string, opts *search.Options) (*search.Response, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("num", "20")
+	if opts != nil && opts.Language != "" {
+		params.Set("hl", opts.Language)
+	}
+	if opts != nil && opts.PageNo > 1 {
+		params.Set("start", fmt.Sprintf("%d", (opts.PageNo-1)*10))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", p.UserAgent)
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("google: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("google: unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("google: reading response: %w", err)
+	}
+
+	out := &search.Response{}
+	for _, m := range resultPattern.FindAllStringSubmatch(string(body), -1) {
+		// m[1] is either the literal "/url?q=" redirect prefix (in which
+		// case m[2] is the query-escaped target on its own) or the scheme
+		// of a direct link (in which case m[2] is merely the scheme-less
+		// remainder and must be reattached to it).
+		var resultURL string
+		if m[1] == "/url?q=" {
+			resultURL = m[2]
+			if decoded, err := url.QueryUnescape(resultURL); err == nil {
+				resultURL = decoded
+			}
+		} else {
+			resultURL = m[1] + m[2]
+		}
+		if !strings.HasPrefix(resultURL, "http") {
+			continue
+		}
+
+		title := strings.TrimSpace(tagStripper.ReplaceAllString(m[3], ""))
+		if title == "" {
+			continue
+		}
+
+		out.Results = append(out.Results, &search.Result{
+			Title:    title,
+			URL:      resultURL,
+			Provider: p.Name(),
+			Kind:     search.KindText,
+		})
+	}
+
+	return out, nil
+}
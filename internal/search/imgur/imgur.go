@@ -0,0 +1,111 @@
+// Package imgur implements search.Provider against the Imgur gallery search
+// API, returning image results.
+package imgur
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/PanacheTechnologies/Champollion/internal/search"
+)
+
+const apiBaseURL = "https://api.imgur.com/3/gallery/search"
+
+// Provider queries the Imgur gallery search API for images. Imgur requires
+// a client ID for all API calls; register one at https://api.imgur.com.
+type Provider struct {
+	ClientID   string
+	HTTPClient *http.Client
+}
+
+// NewProvider creates an Imgur image search provider authenticated with the
+// given client ID.
+func NewProvider(clientID string) *Provider {
+	return &Provider{
+		ClientID:   clientID,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+// Name identifies this provider as "imgur".
+func (p *Provider) Name() string {
+	return "imgur"
+}
+
+type imgurResponse struct {
+	Data []struct {
+		Title  string `json:"title"`
+		Link   string `json:"link"`
+		Cover  string `json:"cover"`
+		Images []struct {
+			Link  string `json:"link"`
+			Title string `json:"title"`
+		} `json:"images"`
+	} `json:"data"`
+}
+
+// Search queries Imgur's gallery search for images matching query.
+func (p *Provider) Search(ctx context.Context, query string, opts *search.Options) (*search.Response, error) {
+	page := 0
+	if opts != nil && opts.PageNo > 0 {
+		page = opts.PageNo - 1
+	}
+
+	reqURL := fmt.Sprintf("%s/time/all/%d?q=%s", apiBaseURL, page, url.QueryEscape(query))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Client-ID "+p.ClientID)
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("imgur: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("imgur: unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("imgur: reading response: %w", err)
+	}
+
+	var parsed imgurResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("imgur: decoding response: %w", err)
+	}
+
+	out := &search.Response{}
+	for _, item := range parsed.Data {
+		// Prefer an image's own Link, which is already a full URL.
+		// item.Cover is only a hash (e.g. "aBcDeF1"), not a URL, so it needs
+		// to be turned into one via Imgur's i.imgur.com host.
+		var imgSrc string
+		if len(item.Images) > 0 {
+			imgSrc = item.Images[0].Link
+		} else if item.Cover != "" {
+			imgSrc = fmt.Sprintf("https://i.imgur.com/%s.jpg", item.Cover)
+		}
+		if imgSrc == "" {
+			continue
+		}
+
+		out.Results = append(out.Results, &search.Result{
+			Title:    item.Title,
+			URL:      item.Link,
+			ImgSrc:   imgSrc,
+			Provider: p.Name(),
+			Kind:     search.KindImage,
+		})
+	}
+
+	return out, nil
+}
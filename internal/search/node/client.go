@@ -0,0 +1,31 @@
+package node
+
+import (
+	"context"
+
+	"github.com/PanacheTechnologies/Champollion/internal/search"
+)
+
+// Client wraps a Node as a search.Provider, so a caller that already builds
+// queries against a search.Provider (e.g. a searxng.Client or Pool wrapped
+// via searxng.NewProvider or searxng.NewPoolProvider) can drop in federated fan-out to peer nodes with
+// no other code changes.
+type Client struct {
+	node *Node
+}
+
+// NewClient wraps node as a search.Provider.
+func NewClient(node *Node) *Client {
+	return &Client{node: node}
+}
+
+// Name identifies this provider as "node".
+func (c *Client) Name() string {
+	return "node"
+}
+
+// Search queries the wrapped node's local provider and its peers, merging
+// and deduplicating the combined results.
+func (c *Client) Search(ctx context.Context, query string, opts *search.Options) (*search.Response, error) {
+	return c.node.Search(ctx, query, opts)
+}
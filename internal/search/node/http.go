@@ -0,0 +1,84 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PanacheTechnologies/Champollion/internal/search"
+)
+
+// Handler returns the http.Handler a caller should mount at
+// "/internal/search" so peers can forward queries to this node.
+func (n *Node) Handler() http.Handler {
+	return http.HandlerFunc(n.handleHTTP)
+}
+
+func (n *Node) handleHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var q query
+	if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	resp, err := n.handleForwarded(ctx, q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// peerClient issues forwarded queries to other nodes' /internal/search
+// endpoints.
+type peerClient struct {
+	httpClient *http.Client
+}
+
+func newPeerClient() *peerClient {
+	return &peerClient{httpClient: http.DefaultClient}
+}
+
+func (c *peerClient) forward(ctx context.Context, addr string, q query) (*search.Response, error) {
+	body, err := json.Marshal(q)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("http://%s/internal/search", addr)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("node: forwarding to peer %s: %w", addr, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("node: peer %s returned status %d", addr, resp.StatusCode)
+	}
+
+	var out search.Response
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("node: decoding peer %s response: %w", addr, err)
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,378 @@
+// Package node lets a Champollion process advertise itself to a small group
+// of peers (via LAN UDP gossip or a static peer list) and forward search
+// queries to them, merging their results with its own local provider's. This
+// generalizes a single process into a small federated search cluster.
+package node
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/PanacheTechnologies/Champollion/internal/search"
+)
+
+// Peer describes a known node in the federation.
+type Peer struct {
+	ID       string
+	Addr     string // host:port of the peer's /internal/search HTTP endpoint
+	LastSeen time.Time
+	// Static marks a peer seeded from Config.StaticPeers. Static peers are
+	// exempt from PeerTTL reaping, since nothing ever re-announces them the
+	// way gossip does.
+	Static bool
+}
+
+// Config configures a Node.
+type Config struct {
+	// GossipAddr is the UDP address announcements are broadcast to and
+	// listened on, e.g. "255.255.255.255:9999". Empty disables gossip.
+	GossipAddr string
+	// AdvertiseAddr is this node's own /internal/search HTTP address,
+	// announced to peers over gossip.
+	AdvertiseAddr string
+	// StaticPeers seeds (or entirely replaces, if gossip is disabled) the
+	// peer table with fixed addresses.
+	StaticPeers []string
+	// GossipInterval is how often this node announces itself. Defaults to 5s.
+	GossipInterval time.Duration
+	// PeerTTL is how long a peer is kept without a fresh announcement or
+	// successful forward before it's dropped. Defaults to 90s.
+	PeerTTL time.Duration
+	// MaxHops bounds how many times a query may be forwarded before it is
+	// answered locally only. Defaults to 2.
+	MaxHops int
+}
+
+func (c Config) withDefaults() Config {
+	if c.GossipInterval <= 0 {
+		c.GossipInterval = 5 * time.Second
+	}
+	if c.PeerTTL <= 0 {
+		c.PeerTTL = 90 * time.Second
+	}
+	if c.MaxHops <= 0 {
+		c.MaxHops = 2
+	}
+	return c
+}
+
+// Node advertises itself over gossip (or a static peer list), tracks peer
+// health via last-seen timestamps, and fans queries out to peers in
+// addition to a local search.Provider.
+type Node struct {
+	ID     string
+	cfg    Config
+	local  search.Provider
+	client *peerClient
+
+	mu    sync.RWMutex
+	peers map[string]*Peer
+
+	seenMu sync.Mutex
+	seen   map[string]time.Time // recently forwarded request IDs, for loop/dedup prevention
+
+	conn *net.UDPConn
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewNode creates a Node identified by id, answering local queries with
+// local. local is typically a searxng.Provider or a search.Aggregator.
+func NewNode(id string, local search.Provider, cfg Config) *Node {
+	cfg = cfg.withDefaults()
+
+	n := &Node{
+		ID:     id,
+		cfg:    cfg,
+		local:  local,
+		client: newPeerClient(),
+		peers:  make(map[string]*Peer),
+		seen:   make(map[string]time.Time),
+		stop:   make(chan struct{}),
+	}
+
+	for _, addr := range cfg.StaticPeers {
+		n.peers[addr] = &Peer{Addr: addr, LastSeen: time.Now(), Static: true}
+	}
+
+	return n
+}
+
+// Start begins gossip announcing/listening (if configured) and background
+// cleanup of stale peers.
+func (n *Node) Start() error {
+	if n.cfg.GossipAddr != "" {
+		if err := n.startGossip(); err != nil {
+			return fmt.Errorf("node: starting gossip: %w", err)
+		}
+	}
+
+	n.wg.Add(1)
+	go n.reapLoop()
+
+	return nil
+}
+
+// Stop halts gossip and background cleanup.
+func (n *Node) Stop() {
+	close(n.stop)
+	if n.conn != nil {
+		n.conn.Close()
+	}
+	n.wg.Wait()
+}
+
+// Peers returns a snapshot of the current peer table.
+func (n *Node) Peers() []*Peer {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	out := make([]*Peer, 0, len(n.peers))
+	for _, p := range n.peers {
+		cp := *p
+		out = append(out, &cp)
+	}
+	return out
+}
+
+func (n *Node) upsertPeer(id, addr string) {
+	if addr == n.cfg.AdvertiseAddr {
+		return // don't add ourselves
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.peers[addr] = &Peer{ID: id, Addr: addr, LastSeen: time.Now()}
+}
+
+func (n *Node) reapLoop() {
+	defer n.wg.Done()
+
+	ticker := time.NewTicker(n.cfg.PeerTTL / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			n.reapStalePeers()
+		case <-n.stop:
+			return
+		}
+	}
+}
+
+func (n *Node) reapStalePeers() {
+	cutoff := time.Now().Add(-n.cfg.PeerTTL)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for addr, p := range n.peers {
+		if !p.Static && p.LastSeen.Before(cutoff) {
+			delete(n.peers, addr)
+		}
+	}
+}
+
+// touchPeer refreshes a peer's LastSeen after a successful forward, so an
+// actively-responding peer never gets reaped purely for being quiet on
+// gossip.
+func (n *Node) touchPeer(addr string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if p, ok := n.peers[addr]; ok {
+		p.LastSeen = time.Now()
+	}
+}
+
+// query is the payload exchanged between nodes, either over the gossip
+// announce channel's companion HTTP endpoint or directly via peerClient.
+type query struct {
+	RequestID string          `json:"request_id"`
+	Query     string          `json:"query"`
+	Options   *search.Options `json:"options,omitempty"`
+	TTL       int             `json:"ttl"`
+}
+
+// Search queries the local provider and every known peer concurrently,
+// merging and deduplicating the combined results by URL.
+func (n *Node) Search(ctx context.Context, q string, opts *search.Options) (*search.Response, error) {
+	return n.dispatch(ctx, query{
+		RequestID: newRequestID(),
+		Query:     q,
+		Options:   opts,
+		TTL:       n.cfg.MaxHops,
+	})
+}
+
+// handleForwarded answers a query forwarded by a peer: local results plus
+// further fan-out if the TTL allows.
+func (n *Node) handleForwarded(ctx context.Context, q query) (*search.Response, error) {
+	return n.dispatch(ctx, q)
+}
+
+func (n *Node) dispatch(ctx context.Context, q query) (*search.Response, error) {
+	if n.alreadySeen(q.RequestID) {
+		return &search.Response{}, nil
+	}
+
+	type partial struct {
+		resp *search.Response
+		err  error
+	}
+
+	peers := n.Peers()
+	results := make(chan partial, len(peers)+1)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		resp, err := n.local.Search(ctx, q.Query, q.Options)
+		results <- partial{resp, err}
+	}()
+
+	if q.TTL > 0 {
+		forwardQuery := q
+		forwardQuery.TTL = q.TTL - 1
+		for _, peer := range peers {
+			wg.Add(1)
+			go func(peer *Peer) {
+				defer wg.Done()
+				resp, err := n.client.forward(ctx, peer.Addr, forwardQuery)
+				if err == nil {
+					n.touchPeer(peer.Addr)
+				}
+				results <- partial{resp, err}
+			}(peer)
+		}
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	merged := &search.Response{}
+	seenURLs := make(map[string]bool)
+	for r := range results {
+		if r.err != nil || r.resp == nil {
+			continue
+		}
+		for _, res := range r.resp.Results {
+			if res.URL != "" {
+				if seenURLs[res.URL] {
+					continue
+				}
+				seenURLs[res.URL] = true
+			}
+			merged.Results = append(merged.Results, res)
+		}
+		merged.Suggestions = append(merged.Suggestions, r.resp.Suggestions...)
+	}
+
+	return merged, nil
+}
+
+func (n *Node) alreadySeen(requestID string) bool {
+	n.seenMu.Lock()
+	defer n.seenMu.Unlock()
+
+	cutoff := time.Now().Add(-time.Minute)
+	for id, at := range n.seen {
+		if at.Before(cutoff) {
+			delete(n.seen, id)
+		}
+	}
+
+	if _, ok := n.seen[requestID]; ok {
+		return true
+	}
+	n.seen[requestID] = time.Now()
+	return false
+}
+
+func newRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// Fall back to a timestamp-derived ID; collisions here only risk an
+		// extra hop of duplicate work, never incorrect results.
+		return fmt.Sprintf("fallback-%d", time.Now().UnixNano())
+	}
+	return fmt.Sprintf("%x", b)
+}
+
+// announcement is the gossip payload broadcast over UDP.
+type announcement struct {
+	ID   string `json:"id"`
+	Addr string `json:"addr"`
+}
+
+func (n *Node) startGossip() error {
+	udpAddr, err := net.ResolveUDPAddr("udp4", n.cfg.GossipAddr)
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{Port: udpAddr.Port})
+	if err != nil {
+		return err
+	}
+	n.conn = conn
+
+	n.wg.Add(2)
+	go n.gossipListen()
+	go n.gossipAnnounce(udpAddr)
+
+	return nil
+}
+
+func (n *Node) gossipListen() {
+	defer n.wg.Done()
+
+	buf := make([]byte, 1024)
+	for {
+		size, _, err := n.conn.ReadFromUDP(buf)
+		if err != nil {
+			return // conn closed on Stop
+		}
+
+		var a announcement
+		if err := json.Unmarshal(buf[:size], &a); err != nil {
+			continue
+		}
+		if a.ID == n.ID {
+			continue
+		}
+		n.upsertPeer(a.ID, a.Addr)
+	}
+}
+
+func (n *Node) gossipAnnounce(dst *net.UDPAddr) {
+	defer n.wg.Done()
+
+	ticker := time.NewTicker(n.cfg.GossipInterval)
+	defer ticker.Stop()
+
+	announce := func() {
+		payload, err := json.Marshal(announcement{ID: n.ID, Addr: n.cfg.AdvertiseAddr})
+		if err != nil {
+			return
+		}
+		n.conn.WriteToUDP(payload, dst)
+	}
+
+	announce()
+	for {
+		select {
+		case <-ticker.C:
+			announce()
+		case <-n.stop:
+			return
+		}
+	}
+}
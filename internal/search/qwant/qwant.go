@@ -0,0 +1,126 @@
+// Package qwant implements search.Provider against Qwant's public JSON
+// search API, covering both the "web" and "images" result categories.
+package qwant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/PanacheTechnologies/Champollion/internal/search"
+)
+
+const apiBaseURL = "https://api.qwant.com/v3/search"
+
+// Category selects which Qwant result category a Provider queries.
+type Category string
+
+const (
+	CategoryWeb    Category = "web"
+	CategoryImages Category = "images"
+)
+
+// Provider queries the Qwant search API for a single Category.
+type Provider struct {
+	Category   Category
+	HTTPClient *http.Client
+}
+
+// NewProvider creates a Qwant search provider for the given category.
+func NewProvider(category Category) *Provider {
+	return &Provider{
+		Category:   category,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+// Name identifies this provider as "qwant-web" or "qwant-images".
+func (p *Provider) Name() string {
+	return "qwant-" + string(p.Category)
+}
+
+type qwantResponse struct {
+	Data struct {
+		Result struct {
+			Items struct {
+				Mainline []struct {
+					Type  string `json:"type"`
+					Items []struct {
+						Title     string `json:"title"`
+						URL       string `json:"url"`
+						Desc      string `json:"desc"`
+						Media     string `json:"media"`
+						Thumbnail string `json:"thumbnail"`
+					} `json:"items"`
+				} `json:"mainline"`
+			} `json:"items"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// Search queries the Qwant API for the given query and category.
+func (p *Provider) Search(ctx context.Context, query string, opts *search.Options) (*search.Response, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("count", "20")
+	params.Set("t", string(p.Category))
+	if opts != nil && opts.Language != "" {
+		params.Set("locale", opts.Language)
+	}
+	if opts != nil && opts.PageNo > 0 {
+		params.Set("offset", fmt.Sprintf("%d", (opts.PageNo-1)*20))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiBaseURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("qwant: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("qwant: unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("qwant: reading response: %w", err)
+	}
+
+	var parsed qwantResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("qwant: decoding response: %w", err)
+	}
+
+	kind := search.KindText
+	if p.Category == CategoryImages {
+		kind = search.KindImage
+	}
+
+	out := &search.Response{}
+	for _, block := range parsed.Data.Result.Items.Mainline {
+		if block.Type != "web" && block.Type != "images" {
+			continue
+		}
+		for _, item := range block.Items {
+			out.Results = append(out.Results, &search.Result{
+				Title:        item.Title,
+				URL:          item.URL,
+				Content:      item.Desc,
+				ImgSrc:       item.Media,
+				ThumbnailSrc: item.Thumbnail,
+				Provider:     p.Name(),
+				Kind:         kind,
+			})
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,54 @@
+// Package search defines a provider-agnostic interface for web search
+// backends, along with shared result types used across SearxNG and direct
+// scraper/API implementations.
+package search
+
+import "context"
+
+// Kind identifies the type of content a Result represents.
+type Kind string
+
+const (
+	KindText  Kind = "text"
+	KindImage Kind = "image"
+	KindVideo Kind = "video"
+)
+
+// Options represents the search parameters supported across providers. Not
+// every provider honors every field.
+type Options struct {
+	Categories []string `json:"categories,omitempty"` // Categories to search in (e.g., "general", "images")
+	Engines    []string `json:"engines,omitempty"`    // Engines to use, for providers that support selecting them
+	Language   string   `json:"language,omitempty"`   // Language code for search results (e.g., "en-US")
+	PageNo     int      `json:"pageno,omitempty"`     // Page number for paginated results (starts at 1)
+}
+
+// Result represents a single result from a provider, normalized across
+// backends.
+type Result struct {
+	Title        string `json:"title"`
+	URL          string `json:"url"`
+	ImgSrc       string `json:"img_src,omitempty"`       // Source URL of the image (for image results)
+	ThumbnailSrc string `json:"thumbnail_src,omitempty"` // Source URL of the thumbnail
+	Content      string `json:"content,omitempty"`       // Snippet or description of the result
+	Author       string `json:"author,omitempty"`        // Author of the content (if available)
+
+	Provider string `json:"provider,omitempty"` // Name of the provider that produced this result
+	Kind     Kind   `json:"kind,omitempty"`     // Whether this result is text, image, or video
+}
+
+// Response represents the complete result set from a provider.
+type Response struct {
+	Results     []*Result `json:"results"`
+	Suggestions []string  `json:"suggestions,omitempty"`
+}
+
+// Provider is implemented by a search backend, whether it talks to a
+// SearxNG instance or scrapes/calls a search engine directly.
+type Provider interface {
+	// Name identifies the provider, e.g. for tagging Result.Provider.
+	Name() string
+
+	// Search performs a search query and returns normalized results.
+	Search(ctx context.Context, query string, opts *Options) (*Response, error)
+}
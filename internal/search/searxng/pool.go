@@ -0,0 +1,285 @@
+package searxng
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// instance tracks the health and rolling latency of a single SearxNG backend.
+type instance struct {
+	baseURL string
+	client  *Client
+
+	mu            sync.Mutex
+	healthy       bool
+	fails         int
+	cooldownUntil time.Time
+	avgLatency    time.Duration
+}
+
+func (i *instance) isAvailable(now time.Time) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.healthy && now.After(i.cooldownUntil)
+}
+
+func (i *instance) recordSuccess(latency time.Duration) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.healthy = true
+	i.fails = 0
+	if i.avgLatency == 0 {
+		i.avgLatency = latency
+		return
+	}
+	// Exponential moving average so a handful of slow requests don't
+	// permanently tank an otherwise fast instance.
+	i.avgLatency = (i.avgLatency*4 + latency) / 5
+}
+
+func (i *instance) recordFailure(maxFailures int, cooldown time.Duration) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.fails++
+	if i.fails >= maxFailures {
+		i.healthy = false
+		i.cooldownUntil = time.Now().Add(cooldown)
+	}
+}
+
+// forceCooldown immediately sidelines the instance for cooldown, bypassing
+// the MaxFailures threshold. Used when an instance reports a 429, which is a
+// clear enough signal on its own.
+func (i *instance) forceCooldown(cooldown time.Duration) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.cooldownUntil = time.Now().Add(cooldown)
+}
+
+// PoolOptions configures the health checking and selection behavior of a Pool.
+type PoolOptions struct {
+	// HealthCheckInterval is how often each instance is probed. Defaults to 30s.
+	HealthCheckInterval time.Duration
+	// HealthCheckPath is requested against each base URL to determine
+	// liveness (e.g. "/config" or "/healthz"). Defaults to "/config".
+	HealthCheckPath string
+	// MaxFailures is the number of consecutive failures (failed health
+	// checks and non-429 query errors alike) before an instance is evicted
+	// for CooldownPeriod. Defaults to 3.
+	MaxFailures int
+	// CooldownPeriod is how long an evicted instance is skipped for queries.
+	// Health checks keep probing it during the cooldown; it serves queries
+	// again once the cooldown has passed and a probe has succeeded.
+	// Defaults to 1 minute.
+	CooldownPeriod time.Duration
+	// HTTPClient is used for health-check probes. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+func (o *PoolOptions) withDefaults() PoolOptions {
+	out := PoolOptions{}
+	if o != nil {
+		out = *o
+	}
+	if out.HealthCheckInterval <= 0 {
+		out.HealthCheckInterval = 30 * time.Second
+	}
+	if out.HealthCheckPath == "" {
+		out.HealthCheckPath = "/config"
+	}
+	if out.MaxFailures <= 0 {
+		out.MaxFailures = 3
+	}
+	if out.CooldownPeriod <= 0 {
+		out.CooldownPeriod = time.Minute
+	}
+	if out.HTTPClient == nil {
+		out.HTTPClient = http.DefaultClient
+	}
+	return out
+}
+
+// Pool balances search queries across multiple SearxNG instances. It probes
+// each instance on a fixed interval to track availability and rolling
+// latency, evicts instances that fail too many consecutive checks for a
+// cooldown window, and selects the next instance for a query via a
+// lowest-latency weighted policy among the instances currently healthy.
+type Pool struct {
+	opts      PoolOptions
+	instances []*instance
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewPool creates a Pool over the given SearxNG base URLs. opts may be nil to
+// accept the defaults. The returned Pool does not start health checking until
+// Start is called.
+func NewPool(baseURLs []string, opts *PoolOptions) *Pool {
+	p := &Pool{
+		opts: opts.withDefaults(),
+		stop: make(chan struct{}),
+	}
+
+	for _, u := range baseURLs {
+		p.instances = append(p.instances, &instance{
+			baseURL: u,
+			// Retries are disabled on the per-instance client: the Pool
+			// owns failover on 429 by cooling down this instance and
+			// re-dispatching to another, so an inner retry loop would only
+			// block behind a rate-limited instance instead of failing over.
+			// A 5xx is not retried either: it counts as a failure against
+			// this instance and is returned to the caller.
+			client: NewClient(u, WithRetry(RetryPolicy{MaxRetries: 0})),
+			// Assume healthy until the first check proves otherwise, so a
+			// freshly constructed Pool can serve queries immediately.
+			healthy: true,
+		})
+	}
+
+	return p
+}
+
+// Start begins periodic health checks in the background. It is safe to call
+// Search before the first check completes.
+func (p *Pool) Start() {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.opts.HealthCheckInterval)
+		defer ticker.Stop()
+
+		p.checkAll()
+		for {
+			select {
+			case <-ticker.C:
+				p.checkAll()
+			case <-p.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts background health checking and waits for it to exit.
+func (p *Pool) Stop() {
+	close(p.stop)
+	p.wg.Wait()
+}
+
+func (p *Pool) checkAll() {
+	for _, inst := range p.instances {
+		go p.checkInstance(inst)
+	}
+}
+
+func (p *Pool) checkInstance(inst *instance) {
+	start := time.Now()
+	req, err := http.NewRequest(http.MethodHead, inst.baseURL+p.opts.HealthCheckPath, nil)
+	if err != nil {
+		inst.recordFailure(p.opts.MaxFailures, p.opts.CooldownPeriod)
+		return
+	}
+
+	resp, err := p.opts.HTTPClient.Do(req)
+	if err != nil {
+		inst.recordFailure(p.opts.MaxFailures, p.opts.CooldownPeriod)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 500 {
+		inst.recordFailure(p.opts.MaxFailures, p.opts.CooldownPeriod)
+		return
+	}
+
+	inst.recordSuccess(time.Since(start))
+}
+
+// pick selects a healthy, non-cooling-down instance not already in
+// excluded, weighted towards lower rolling latency. Instances with no
+// recorded latency yet (newly healthy, or never successfully probed) are
+// treated as the fastest so they get a chance to prove themselves.
+func (p *Pool) pick(excluded map[*instance]bool) (*instance, error) {
+	now := time.Now()
+
+	var available []*instance
+	for _, inst := range p.instances {
+		if !excluded[inst] && inst.isAvailable(now) {
+			available = append(available, inst)
+		}
+	}
+
+	if len(available) == 0 {
+		return nil, fmt.Errorf("searxng: no healthy instances available")
+	}
+
+	weights := make([]float64, len(available))
+	var total float64
+	for i, inst := range available {
+		inst.mu.Lock()
+		latency := inst.avgLatency
+		inst.mu.Unlock()
+
+		w := 1.0
+		if latency > 0 {
+			w = 1.0 / float64(latency)
+		}
+		weights[i] = w
+		total += w
+	}
+
+	r := rand.Float64() * total
+	for i, w := range weights {
+		r -= w
+		if r <= 0 {
+			return available[i], nil
+		}
+	}
+
+	return available[len(available)-1], nil
+}
+
+// Search performs a search query against a healthy instance chosen by the
+// pool's weighted selection policy. It is equivalent to SearchCtx with
+// context.Background().
+func (p *Pool) Search(query string, opts *SearchOptions) (*SearchResponse, error) {
+	return p.SearchCtx(context.Background(), query, opts)
+}
+
+// SearchCtx performs a search query, honoring ctx cancellation/deadlines. If
+// the chosen instance returns a 429, it is put into cooldown immediately
+// (bypassing MaxFailures) and the query is re-dispatched to another healthy
+// instance, up to once per remaining instance.
+func (p *Pool) SearchCtx(ctx context.Context, query string, opts *SearchOptions) (*SearchResponse, error) {
+	tried := make(map[*instance]bool)
+
+	for {
+		inst, err := p.pick(tried)
+		if err != nil {
+			return nil, err
+		}
+		tried[inst] = true
+
+		start := time.Now()
+		resp, err := inst.client.SearchCtx(ctx, query, opts)
+		if err == nil {
+			inst.recordSuccess(time.Since(start))
+			return resp, nil
+		}
+
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusTooManyRequests {
+			inst.forceCooldown(p.opts.CooldownPeriod)
+			continue
+		}
+
+		inst.recordFailure(p.opts.MaxFailures, p.opts.CooldownPeriod)
+		return nil, err
+	}
+}
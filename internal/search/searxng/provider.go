@@ -0,0 +1,75 @@
+package searxng
+
+import (
+	"context"
+
+	"github.com/PanacheTechnologies/Champollion/internal/search"
+)
+
+// Provider adapts a Client (or Pool) to the search.Provider interface so it
+// can be used interchangeably with other backends, e.g. via a
+// search.Aggregator.
+type Provider struct {
+	searcher interface {
+		SearchCtx(ctx context.Context, query string, opts *SearchOptions) (*SearchResponse, error)
+	}
+}
+
+// NewProvider wraps a Client as a search.Provider.
+func NewProvider(c *Client) *Provider {
+	return &Provider{searcher: c}
+}
+
+// NewPoolProvider wraps a Pool as a search.Provider.
+func NewPoolProvider(p *Pool) *Provider {
+	return &Provider{searcher: p}
+}
+
+// Name identifies this provider as "searxng".
+func (p *Provider) Name() string {
+	return "searxng"
+}
+
+// Search implements search.Provider.
+func (p *Provider) Search(ctx context.Context, query string, opts *search.Options) (*search.Response, error) {
+	resp, err := p.searcher.SearchCtx(ctx, query, toSearxOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return toSearchResponse(resp), nil
+}
+
+func toSearxOptions(opts *search.Options) *SearchOptions {
+	if opts == nil {
+		return nil
+	}
+	return &SearchOptions{
+		Categories: opts.Categories,
+		Engines:    opts.Engines,
+		Language:   opts.Language,
+		PageNo:     opts.PageNo,
+	}
+}
+
+func toSearchResponse(resp *SearchResponse) *search.Response {
+	out := &search.Response{Suggestions: resp.Suggestions}
+	for _, r := range resp.Results {
+		kind := search.KindText
+		if r.ImgSrc != "" {
+			kind = search.KindImage
+		}
+
+		out.Results = append(out.Results, &search.Result{
+			Title:        r.Title,
+			URL:          r.URL,
+			ImgSrc:       r.ImgSrc,
+			ThumbnailSrc: r.ThumbnailSrc,
+			Content:      r.Content,
+			Author:       r.Author,
+			Provider:     "searxng",
+			Kind:         kind,
+		})
+	}
+	return out
+}
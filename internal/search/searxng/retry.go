@@ -0,0 +1,61 @@
+package searxng
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how SearchCtx retries transient failures.
+type RetryPolicy struct {
+	MaxRetries int           // Number of retries after the initial attempt
+	BaseDelay  time.Duration // Delay before the first retry, doubled each subsequent retry
+	MaxDelay   time.Duration // Ceiling on the backoff delay
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when a Client is
+// constructed without WithRetry: 3 retries, starting at 200ms and doubling
+// up to a 5s ceiling.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  200 * time.Millisecond,
+		MaxDelay:   5 * time.Second,
+	}
+}
+
+// StatusError indicates a SearxNG instance responded with a retryable
+// status (429 or 5xx), optionally with a server-specified Retry-After.
+type StatusError struct {
+	StatusCode int
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("searxng: instance returned status %d", e.StatusCode)
+}
+
+// newStatusError builds a StatusError from a response, parsing Retry-After
+// if present (either as a number of seconds or an HTTP-date).
+func newStatusError(resp *http.Response) *StatusError {
+	e := &StatusError{StatusCode: resp.StatusCode}
+
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return e
+	}
+
+	if secs, err := strconv.Atoi(header); err == nil {
+		e.RetryAfter = time.Duration(secs) * time.Second
+		return e
+	}
+
+	if when, err := http.ParseTime(header); err == nil {
+		if d := time.Until(when); d > 0 {
+			e.RetryAfter = d
+		}
+	}
+
+	return e
+}
@@ -1,24 +1,70 @@
 package searxng
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/PanacheTechnologies/Champollion/pkg/useragent"
 )
 
 // Client represents a SearxNG API client
 type Client struct {
-	baseURL string // The base URL of the SearxNG instance
+	baseURL    string // The base URL of the SearxNG instance
+	httpClient *http.Client
+	userAgents *useragent.Rotator
+	limiter    *rate.Limiter
+	retry      RetryPolicy
+}
+
+// Option configures a Client constructed with NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the http.Client used to issue requests. Defaults
+// to http.DefaultClient.
+func WithHTTPClient(c *http.Client) Option {
+	return func(cl *Client) { cl.httpClient = c }
+}
+
+// WithRateLimit caps outbound requests to this instance to r requests per
+// second, with bursts up to burst. Defaults to 1 req/sec, burst 3.
+func WithRateLimit(r rate.Limit, burst int) Option {
+	return func(cl *Client) { cl.limiter = rate.NewLimiter(r, burst) }
+}
+
+// WithRetry overrides the retry policy applied to 429/5xx responses.
+func WithRetry(policy RetryPolicy) Option {
+	return func(cl *Client) { cl.retry = policy }
+}
+
+// WithUserAgent equips the client with a Rotator so each outbound request
+// carries a plausible, recent desktop User-Agent instead of Go's default.
+// Public SearxNG instances frequently rate-limit or block the default UA.
+func WithUserAgent(r *useragent.Rotator) Option {
+	return func(cl *Client) { cl.userAgents = r }
 }
 
-// NewClient creates a new SearxNG client with the specified base URL
-func NewClient(baseURL string) *Client {
-	return &Client{
-		baseURL: baseURL,
+// NewClient creates a new SearxNG client with the specified base URL.
+func NewClient(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+		limiter:    rate.NewLimiter(rate.Limit(1), 3),
+		retry:      DefaultRetryPolicy(),
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 // SearchOptions represents the available search parameters for SearxNG
@@ -47,7 +93,8 @@ type SearchResponse struct {
 	Suggestions []string        `json:"suggestions,omitempty"` // Search suggestions based on the query
 }
 
-// Search performs a search query against the SearxNG instance
+// Search performs a search query against the SearxNG instance. It is
+// equivalent to SearchCtx with context.Background().
 //
 // Parameters:
 //   - query: The search term or phrase
@@ -57,18 +104,66 @@ type SearchResponse struct {
 //   - *SearchResponse: Contains search results and suggestions
 //   - error: Any error that occurred during the search
 func (c *Client) Search(query string, opts *SearchOptions) (*SearchResponse, error) {
-	// Construct the base URL with the search query
+	return c.SearchCtx(context.Background(), query, opts)
+}
+
+// SearchCtx performs a search query against the SearxNG instance, honoring
+// ctx cancellation/deadlines. It applies the client's rate limiter before
+// each attempt and retries on 429/5xx responses per the client's
+// RetryPolicy, honoring a Retry-After header when present.
+func (c *Client) SearchCtx(ctx context.Context, query string, opts *SearchOptions) (*SearchResponse, error) {
+	searchURL, err := c.buildSearchURL(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	delay := c.retry.BaseDelay
+
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.doRequest(ctx, searchURL)
+		if err == nil {
+			return resp, nil
+		}
+
+		statusErr, retryable := err.(*StatusError)
+		if !retryable || attempt >= c.retry.MaxRetries {
+			return nil, err
+		}
+
+		wait := delay
+		if statusErr.RetryAfter > 0 {
+			wait = statusErr.RetryAfter
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		delay *= 2
+		if delay > c.retry.MaxDelay {
+			delay = c.retry.MaxDelay
+		}
+	}
+}
+
+func (c *Client) buildSearchURL(query string, opts *SearchOptions) (*url.URL, error) {
 	baseURL, err := url.Parse(c.baseURL)
 	if err != nil {
 		return nil, fmt.Errorf("invalid base URL: %w", err)
 	}
 
-	// Create query parameters
 	params := url.Values{}
 	params.Set("format", "json")
 	params.Set("q", query)
 
-	// Add optional parameters if provided
 	if opts != nil {
 		if len(opts.Categories) > 0 {
 			params.Set("categories", strings.Join(opts.Categories, ","))
@@ -87,31 +182,38 @@ func (c *Client) Search(query string, opts *SearchOptions) (*SearchResponse, err
 		}
 	}
 
-	// Construct the final URL
 	searchURL := baseURL.JoinPath("search")
 	searchURL.RawQuery = params.Encode()
+	return searchURL, nil
+}
 
-	// Create and execute the HTTP request
-	req, err := http.NewRequest(http.MethodGet, searchURL.String(), nil)
+func (c *Client) doRequest(ctx context.Context, searchURL *url.URL) (*SearchResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	if c.userAgents != nil {
+		req.Header.Set("User-Agent", c.userAgents.Pick())
+	}
+
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	// Read and parse the response
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return nil, newStatusError(resp)
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
 
 	var searchResp SearchResponse
-	err = json.Unmarshal(body, &searchResp)
-	if err != nil {
+	if err := json.Unmarshal(body, &searchResp); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,41 @@
+// Package config loads a typed Config for a Champollion process from a
+// layered chain — built-in defaults, then config.json, then config.ini,
+// then environment variables, then explicit overrides — and can watch the
+// config files for changes and publish updates to subscribers.
+package config
+
+import "time"
+
+// Config is the fully resolved configuration for a Champollion process.
+type Config struct {
+	// SearxNGURLs lists the SearxNG base URLs to pool across.
+	SearxNGURLs []string `json:"searxng_urls"`
+	// DefaultCategories is used for searches that don't specify categories.
+	DefaultCategories []string `json:"default_categories"`
+	// DefaultLanguage is used for searches that don't specify a language.
+	DefaultLanguage string `json:"default_language"`
+	// RateLimitPerSecond is the default per-instance request rate limit.
+	RateLimitPerSecond float64 `json:"rate_limit_per_second"`
+	// RateLimitBurst is the default per-instance burst allowance.
+	RateLimitBurst int `json:"rate_limit_burst"`
+	// UserAgentDatasetURL overrides the browser usage-share dataset fetched
+	// by pkg/useragent. Empty uses that package's built-in default.
+	UserAgentDatasetURL string `json:"user_agent_dataset_url"`
+	// UserAgentTTL controls how often the User-Agent dataset is refreshed.
+	UserAgentTTL time.Duration `json:"user_agent_ttl"`
+	// PeerNodes seeds internal/search/node's static peer list.
+	PeerNodes []string `json:"peer_nodes"`
+}
+
+// defaults returns the built-in baseline Config, the first link in the load
+// chain.
+func defaults() Config {
+	return Config{
+		SearxNGURLs:        []string{"http://localhost:8080"},
+		DefaultCategories:  []string{"general"},
+		DefaultLanguage:    "en-US",
+		RateLimitPerSecond: 1,
+		RateLimitBurst:     3,
+		UserAgentTTL:       24 * time.Hour,
+	}
+}
@@ -0,0 +1,198 @@
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvOrDefault returns the environment variable named key, or fallback if
+// it's unset. pkg/env.GetVar is a thin shim over this, kept for simple call
+// sites that don't need a full layered Config.
+func EnvOrDefault(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return fallback
+}
+
+// load resolves a Config through the full chain: defaults, then jsonPath if
+// set, then iniPath if set, then environment variables, then overrides. A
+// missing config.json/config.ini is not an error — that layer is simply
+// skipped.
+func load(jsonPath, iniPath string, overrides *Config) (*Config, error) {
+	cfg := defaults()
+
+	if jsonPath != "" {
+		if err := applyJSON(&cfg, jsonPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if iniPath != "" {
+		if err := applyINI(&cfg, iniPath); err != nil {
+			return nil, err
+		}
+	}
+
+	applyEnv(&cfg)
+
+	if overrides != nil {
+		applyOverrides(&cfg, overrides)
+	}
+
+	return &cfg, nil
+}
+
+func applyJSON(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	return json.Unmarshal(data, cfg)
+}
+
+// applyINI applies a minimal "key = value" INI file over cfg, with values
+// under section headers addressed as "[section]" merged flat (section names
+// are accepted but not nested into the Config). Comma-separated values
+// populate slice fields; comments (";" or "#") and blank lines are skipped.
+func applyINI(cfg *Config, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			continue // section headers are ignored; keys are flat
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		applyKeyValue(cfg, strings.TrimSpace(key), strings.TrimSpace(value))
+	}
+
+	return scanner.Err()
+}
+
+// applyEnv layers environment variables over cfg, using the same
+// fallback-on-missing semantics as EnvOrDefault (and, transitively,
+// pkg/env.GetVar) everywhere else in this codebase.
+func applyEnv(cfg *Config) {
+	if v := EnvOrDefault("SEARXNG_URLS", ""); v != "" {
+		cfg.SearxNGURLs = splitList(v)
+	}
+	if v := EnvOrDefault("SEARXNG_DEFAULT_CATEGORIES", ""); v != "" {
+		cfg.DefaultCategories = splitList(v)
+	}
+	if v := EnvOrDefault("SEARXNG_DEFAULT_LANGUAGE", ""); v != "" {
+		cfg.DefaultLanguage = v
+	}
+	if v := EnvOrDefault("SEARXNG_RATE_LIMIT_PER_SECOND", ""); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			cfg.RateLimitPerSecond = f
+		}
+	}
+	if v := EnvOrDefault("SEARXNG_RATE_LIMIT_BURST", ""); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.RateLimitBurst = n
+		}
+	}
+	if v := EnvOrDefault("SEARXNG_USER_AGENT_DATASET_URL", ""); v != "" {
+		cfg.UserAgentDatasetURL = v
+	}
+	if v := EnvOrDefault("SEARXNG_USER_AGENT_TTL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.UserAgentTTL = d
+		}
+	}
+	if v := EnvOrDefault("SEARXNG_PEER_NODES", ""); v != "" {
+		cfg.PeerNodes = splitList(v)
+	}
+}
+
+// applyOverrides copies every non-zero-value field of overrides onto cfg,
+// so callers can force specific settings (e.g. from CLI flags) without
+// having to know which layer set the rest.
+func applyOverrides(cfg *Config, overrides *Config) {
+	if len(overrides.SearxNGURLs) > 0 {
+		cfg.SearxNGURLs = overrides.SearxNGURLs
+	}
+	if len(overrides.DefaultCategories) > 0 {
+		cfg.DefaultCategories = overrides.DefaultCategories
+	}
+	if overrides.DefaultLanguage != "" {
+		cfg.DefaultLanguage = overrides.DefaultLanguage
+	}
+	if overrides.RateLimitPerSecond != 0 {
+		cfg.RateLimitPerSecond = overrides.RateLimitPerSecond
+	}
+	if overrides.RateLimitBurst != 0 {
+		cfg.RateLimitBurst = overrides.RateLimitBurst
+	}
+	if overrides.UserAgentDatasetURL != "" {
+		cfg.UserAgentDatasetURL = overrides.UserAgentDatasetURL
+	}
+	if overrides.UserAgentTTL != 0 {
+		cfg.UserAgentTTL = overrides.UserAgentTTL
+	}
+	if len(overrides.PeerNodes) > 0 {
+		cfg.PeerNodes = overrides.PeerNodes
+	}
+}
+
+func applyKeyValue(cfg *Config, key, value string) {
+	switch key {
+	case "searxng_urls":
+		cfg.SearxNGURLs = splitList(value)
+	case "default_categories":
+		cfg.DefaultCategories = splitList(value)
+	case "default_language":
+		cfg.DefaultLanguage = value
+	case "rate_limit_per_second":
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			cfg.RateLimitPerSecond = f
+		}
+	case "rate_limit_burst":
+		if n, err := strconv.Atoi(value); err == nil {
+			cfg.RateLimitBurst = n
+		}
+	case "user_agent_dataset_url":
+		cfg.UserAgentDatasetURL = value
+	case "user_agent_ttl":
+		if d, err := time.ParseDuration(value); err == nil {
+			cfg.UserAgentTTL = d
+		}
+	case "peer_nodes":
+		cfg.PeerNodes = splitList(value)
+	}
+}
+
+func splitList(v string) []string {
+	parts := strings.Split(v, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
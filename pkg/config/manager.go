@@ -0,0 +1,152 @@
+package config
+
+import (
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Manager owns a Config resolved through the layered load chain and can
+// watch config.json/config.ini for changes, publishing updates through
+// Subscribe so long-lived components (an instance pool, a rate limiter)
+// can reconfigure at runtime without a restart.
+type Manager struct {
+	jsonPath  string
+	iniPath   string
+	overrides *Config
+
+	mu      sync.RWMutex
+	current *Config
+
+	subsMu sync.Mutex
+	subs   []chan *Config
+
+	watcher *fsnotify.Watcher
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewManager loads a Config from jsonPath and iniPath (either may be empty
+// to skip that layer) plus environment variables and overrides, in that
+// order. It does not watch for changes until Watch is called.
+func NewManager(jsonPath, iniPath string, overrides *Config) (*Manager, error) {
+	cfg, err := load(jsonPath, iniPath, overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Manager{
+		jsonPath:  jsonPath,
+		iniPath:   iniPath,
+		overrides: overrides,
+		current:   cfg,
+	}, nil
+}
+
+// Current returns a copy of the most recently resolved Config.
+func (m *Manager) Current() *Config {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	cp := *m.current
+	return &cp
+}
+
+// Subscribe returns a channel that receives the new Config every time a
+// watched file changes and the chain is successfully re-resolved. The
+// channel is buffered by one and never closed; a slow subscriber only ever
+// sees the latest update.
+func (m *Manager) Subscribe() <-chan *Config {
+	ch := make(chan *Config, 1)
+
+	m.subsMu.Lock()
+	m.subs = append(m.subs, ch)
+	m.subsMu.Unlock()
+
+	return ch
+}
+
+// Watch begins watching config.json and config.ini (whichever are set) for
+// changes, reloading the chain and publishing to subscribers on each one.
+func (m *Manager) Watch() error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range []string{m.jsonPath, m.iniPath} {
+		if path == "" {
+			continue
+		}
+		// Errors adding a watch (e.g. the file doesn't exist yet) are
+		// tolerated: that layer simply won't hot-reload until it's created
+		// and Watch is called again.
+		_ = w.Add(path)
+	}
+
+	m.watcher = w
+	m.stop = make(chan struct{})
+
+	m.wg.Add(1)
+	go m.watchLoop()
+
+	return nil
+}
+
+// Close stops watching and releases the underlying watcher.
+func (m *Manager) Close() error {
+	if m.watcher == nil {
+		return nil
+	}
+	close(m.stop)
+	err := m.watcher.Close()
+	m.wg.Wait()
+	return err
+}
+
+func (m *Manager) watchLoop() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case event, ok := <-m.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			m.reload()
+
+		case _, ok := <-m.watcher.Errors:
+			if !ok {
+				return
+			}
+
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+func (m *Manager) reload() {
+	cfg, err := load(m.jsonPath, m.iniPath, m.overrides)
+	if err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	m.current = cfg
+	m.mu.Unlock()
+
+	m.subsMu.Lock()
+	defer m.subsMu.Unlock()
+	for _, ch := range m.subs {
+		// Drain any stale, unread config before sending so the buffer
+		// always holds the latest rather than whatever was first to arrive.
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- cfg
+	}
+}
@@ -1,11 +1,10 @@
 package env
 
-import "os"
+import "github.com/PanacheTechnologies/Champollion/pkg/config"
 
+// GetVar is a thin shim over pkg/config's environment resolution, kept for
+// simple call sites that just want one variable rather than a full layered
+// Config.
 func GetVar(key, fallback string) string {
-	if value, ok := os.LookupEnv(key); ok {
-		return value
-	}
-
-	return fallback
+	return config.EnvOrDefault(key, fallback)
 }
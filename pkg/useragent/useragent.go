@@ -0,0 +1,241 @@
+// Package useragent serves desktop browser User-Agent strings sampled in
+// proportion to real-world usage share, so outbound HTTP clients don't have
+// to rely on Go's default (and widely blocklisted) User-Agent.
+package useragent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// defaultDatasetURL points at the caniuse browser usage-share dataset, which
+// is refreshed regularly and freely available.
+const defaultDatasetURL = "https://raw.githubusercontent.com/Fyrd/caniuse/main/fulldata-json/data-2.0.json"
+
+// Entry is a single User-Agent string and its relative sampling weight.
+type Entry struct {
+	UserAgent string
+	Weight    float64
+}
+
+// fallbackEntries is used until the first successful fetch. A later failed
+// fetch keeps whatever entries were last in use, so once a fetch has
+// succeeded the fetched list stays in place.
+var fallbackEntries = []Entry{
+	{UserAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36", Weight: 0.55},
+	{UserAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Safari/605.1.15", Weight: 0.18},
+	{UserAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:125.0) Gecko/20100101 Firefox/125.0", Weight: 0.15},
+	{UserAgent: "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36", Weight: 0.07},
+	{UserAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0", Weight: 0.05},
+}
+
+// Rotator hands out User-Agent strings sampled proportional to usage share,
+// refreshing its dataset from a remote source on a TTL. The zero value is
+// not usable; construct one with New.
+type Rotator struct {
+	datasetURL string
+	ttl        time.Duration
+	httpClient *http.Client
+	pinned     bool
+
+	mu        sync.RWMutex
+	entries   []Entry
+	fetchedAt time.Time
+}
+
+// Option configures a Rotator constructed with New.
+type Option func(*Rotator)
+
+// WithDatasetURL overrides the caniuse dataset URL fetched on refresh.
+func WithDatasetURL(u string) Option {
+	return func(r *Rotator) { r.datasetURL = u }
+}
+
+// WithTTL overrides how long a fetched dataset is used before refreshing.
+// Defaults to 24 hours.
+func WithTTL(ttl time.Duration) Option {
+	return func(r *Rotator) { r.ttl = ttl }
+}
+
+// WithHTTPClient overrides the HTTP client used to fetch the dataset.
+func WithHTTPClient(c *http.Client) Option {
+	return func(r *Rotator) { r.httpClient = c }
+}
+
+// WithEntries seeds the Rotator with a caller-supplied list instead of the
+// built-in fallback, and pins it so no remote fetch ever overwrites it. Use
+// this to inject your own dataset or a fixed list of User-Agents.
+func WithEntries(entries []Entry) Option {
+	return func(r *Rotator) {
+		r.entries = entries
+		r.pinned = true
+	}
+}
+
+// New creates a Rotator. It starts out serving the built-in fallback list
+// (or the list from WithEntries) and fetches the real dataset lazily on the
+// first call to Pick.
+func New(opts ...Option) *Rotator {
+	r := &Rotator{
+		datasetURL: defaultDatasetURL,
+		ttl:        24 * time.Hour,
+		httpClient: http.DefaultClient,
+		entries:    fallbackEntries,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Pick returns a User-Agent string sampled with probability proportional to
+// usage share, refreshing the backing dataset first if the TTL has elapsed.
+func (r *Rotator) Pick() string {
+	r.maybeRefresh()
+
+	r.mu.RLock()
+	entries := r.entries
+	r.mu.RUnlock()
+
+	if len(entries) == 0 {
+		return fallbackEntries[0].UserAgent
+	}
+	return weightedPick(entries)
+}
+
+func weightedPick(entries []Entry) string {
+	var total float64
+	for _, e := range entries {
+		total += e.Weight
+	}
+	if total <= 0 {
+		return entries[0].UserAgent
+	}
+
+	pick := rand.Float64() * total
+	for _, e := range entries {
+		pick -= e.Weight
+		if pick <= 0 {
+			return e.UserAgent
+		}
+	}
+	return entries[len(entries)-1].UserAgent
+}
+
+func (r *Rotator) maybeRefresh() {
+	if r.pinned {
+		return
+	}
+
+	r.mu.RLock()
+	stale := time.Since(r.fetchedAt) > r.ttl
+	r.mu.RUnlock()
+	if !stale {
+		return
+	}
+
+	entries, err := fetchEntries(r.httpClient, r.datasetURL)
+	if err != nil {
+		// Leave the existing entries in place and back off for another TTL
+		// window rather than retrying a dead endpoint on every call.
+		r.mu.Lock()
+		r.fetchedAt = time.Now()
+		r.mu.Unlock()
+		return
+	}
+
+	r.mu.Lock()
+	r.entries = entries
+	r.fetchedAt = time.Now()
+	r.mu.Unlock()
+}
+
+type caniuseData struct {
+	Agents map[string]struct {
+		UsageGlobal map[string]float64 `json:"usage_global"`
+	} `json:"agents"`
+}
+
+// formatUA renders a full desktop UA string for the given caniuse agent key
+// and version, or "" if the browser isn't one this package models.
+func formatUA(browser, version string) string {
+	switch browser {
+	case "chrome":
+		return fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s.0.0.0 Safari/537.36", version)
+	case "firefox":
+		return fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:%s.0) Gecko/20100101 Firefox/%s.0", version, version)
+	default:
+		return ""
+	}
+}
+
+// fetchEntries downloads and parses the caniuse dataset, extracting Chrome
+// and Firefox versions with their global usage share as sampling weights.
+func fetchEntries(client *http.Client, datasetURL string) ([]Entry, error) {
+	resp, err := client.Get(datasetURL)
+	if err != nil {
+		return nil, fmt.Errorf("useragent: fetching dataset: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("useragent: unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("useragent: reading dataset: %w", err)
+	}
+
+	var parsed caniuseData
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("useragent: decoding dataset: %w", err)
+	}
+
+	var entries []Entry
+	for _, browser := range []string{"chrome", "firefox"} {
+		agent, ok := parsed.Agents[browser]
+		if !ok {
+			continue
+		}
+
+		versions := topVersions(agent.UsageGlobal, 3)
+		for _, v := range versions {
+			share := agent.UsageGlobal[v]
+			if share <= 0 {
+				continue
+			}
+			entries = append(entries, Entry{
+				UserAgent: formatUA(browser, v),
+				Weight:    share,
+			})
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("useragent: dataset contained no usable browser versions")
+	}
+
+	return entries, nil
+}
+
+// topVersions returns up to n version strings with the highest usage share.
+func topVersions(usage map[string]float64, n int) []string {
+	versions := make([]string, 0, len(usage))
+	for v := range usage {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return usage[versions[i]] > usage[versions[j]]
+	})
+	if len(versions) > n {
+		versions = versions[:n]
+	}
+	return versions
+}